@@ -0,0 +1,232 @@
+package oshi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const defaultBatchConcurrency = 4
+
+// BatchResult is the outcome of a single item in an UploadBatch call.
+type BatchResult struct {
+	Response UploadResponse
+	Err      error
+	Elapsed  time.Duration
+}
+
+type batchOptions struct {
+	concurrency   int
+	failFast      bool
+	retryAttempts int
+	retryBackoff  time.Duration
+}
+
+// BatchOption helps to configurate a single UploadBatch or DeleteBatch call.
+type BatchOption func(opts *batchOptions)
+
+// WithBatchConcurrency overrides the default number of items processed concurrently.
+func WithBatchConcurrency(n int) BatchOption {
+	return func(opts *batchOptions) {
+		opts.concurrency = n
+	}
+}
+
+// WithBatchFailFast cancels remaining items via the shared context as soon as one
+// item fails.
+func WithBatchFailFast(failFast bool) BatchOption {
+	return func(opts *batchOptions) {
+		opts.failFast = failFast
+	}
+}
+
+// WithBatchRetry retries a failing item up to attempts times, sleeping backoff
+// between attempts, for transient failures (network errors and 5xx responses).
+// attempts below 1 is raised to 1: every item is always tried at least once.
+func WithBatchRetry(attempts int, backoff time.Duration) BatchOption {
+	return func(opts *batchOptions) {
+		if attempts < 1 {
+			attempts = 1
+		}
+
+		opts.retryAttempts = attempts
+		opts.retryBackoff = backoff
+	}
+}
+
+// UploadBatch uploads imgs concurrently over a bounded worker pool, returning one
+// BatchResult per image in the same order as imgs.
+func (c *Client) UploadBatch(ctx context.Context, imgs []*Image, opts ...BatchOption) ([]BatchResult, error) {
+	options := batchOptions{concurrency: defaultBatchConcurrency, retryAttempts: 1}
+	for _, o := range opts {
+		o(&options)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]BatchResult, len(imgs))
+
+	runBatch(ctx, len(imgs), options, func(ctx context.Context, i int) error {
+		start := time.Now()
+
+		resp, err := c.uploadWithRetry(ctx, imgs[i], options)
+
+		results[i] = BatchResult{Response: resp, Err: err, Elapsed: time.Since(start)}
+
+		if err != nil && options.failFast {
+			cancel()
+		}
+
+		return err
+	}, func(i int) {
+		results[i] = BatchResult{Err: ctx.Err()}
+	})
+
+	return results, nil
+}
+
+// DeleteBatch deletes the files at adminURLs concurrently over a bounded worker pool,
+// returning one error per URL in the same order as adminURLs (nil on success).
+func (c *Client) DeleteBatch(ctx context.Context, adminURLs []string, opts ...BatchOption) []error {
+	options := batchOptions{concurrency: defaultBatchConcurrency, retryAttempts: 1}
+	for _, o := range opts {
+		o(&options)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errs := make([]error, len(adminURLs))
+
+	runBatch(ctx, len(adminURLs), options, func(ctx context.Context, i int) error {
+		err := c.deleteWithRetry(ctx, adminURLs[i], options)
+		errs[i] = err
+
+		if err != nil && options.failFast {
+			cancel()
+		}
+
+		return err
+	}, func(i int) {
+		errs[i] = ctx.Err()
+	})
+
+	return errs
+}
+
+// runBatch fans out [0, n) over a pool of options.concurrency workers, calling fn for
+// each index. It blocks until every index has been processed or the context is done.
+// Indexes that are never dispatched because the context was already cancelled (e.g. by
+// WithBatchFailFast) are reported via onSkip instead of being left as zero values, so
+// callers can tell a cancelled item apart from a successful one.
+func runBatch(ctx context.Context, n int, options batchOptions, fn func(ctx context.Context, i int) error, onSkip func(i int)) {
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < options.concurrency; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				if ctx.Err() != nil {
+					onSkip(i)
+					continue
+				}
+
+				_ = fn(ctx, i)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(indexes)
+
+		for i := 0; i < n; i++ {
+			select {
+			case <-ctx.Done():
+				for ; i < n; i++ {
+					onSkip(i)
+				}
+
+				return
+			case indexes <- i:
+			}
+		}
+	}()
+
+	wg.Wait()
+}
+
+func (c *Client) uploadWithRetry(ctx context.Context, img *Image, options batchOptions) (UploadResponse, error) {
+	var (
+		resp UploadResponse
+		err  error
+	)
+
+	for attempt := 1; attempt <= options.retryAttempts; attempt++ {
+		resp, err = c.Upload(ctx, img)
+		if err == nil || !isTransient(err) {
+			return resp, err
+		}
+
+		if attempt < options.retryAttempts {
+			if sleepErr := sleepContext(ctx, options.retryBackoff); sleepErr != nil {
+				return resp, sleepErr
+			}
+		}
+	}
+
+	return resp, err
+}
+
+func (c *Client) deleteWithRetry(ctx context.Context, adminURL string, options batchOptions) error {
+	var err error
+
+	for attempt := 1; attempt <= options.retryAttempts; attempt++ {
+		err = c.Delete(ctx, adminURL)
+		if err == nil || !isTransient(err) {
+			return err
+		}
+
+		if attempt < options.retryAttempts {
+			if sleepErr := sleepContext(ctx, options.retryBackoff); sleepErr != nil {
+				return sleepErr
+			}
+		}
+	}
+
+	return err
+}
+
+// isTransient reports whether err is worth retrying: a network-level error or a 5xx
+// response from the server.
+func isTransient(err error) bool {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	return err != nil
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
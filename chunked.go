@@ -0,0 +1,356 @@
+package oshi
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultChunkSize          = 32 * 1024 * 1024 // 32 MiB
+	defaultUploadConcurrency  = 4
+	chunkUploadMaxAttempts    = 5
+	chunkUploadBackoffInitial = 100 * time.Millisecond
+	chunkUploadBackoffMax     = 5 * time.Second
+)
+
+// UploadState captures enough information about an in-flight chunked upload to resume
+// it after a process restart. It is safe to marshal to JSON and persist to disk.
+//
+// Offset is a contiguous high-water mark: it only ever advances past chunks that have
+// completed in order, so resuming from it never skips over a chunk that failed.
+// ChunkChecksums is keyed by chunk index (chunk start offset / chunk size), not by
+// completion order, so it can be consulted even when chunks finish out of order.
+type UploadState struct {
+	UploadURL      string           `json:"upload_url"`
+	Offset         int64            `json:"offset"`
+	ChunkChecksums map[int64]string `json:"chunk_checksums"`
+}
+
+type chunkedOptions struct {
+	chunkSize   int64
+	concurrency int
+	resume      *UploadState
+}
+
+// ChunkedOption helps to configurate a single UploadChunked call.
+type ChunkedOption func(opts *chunkedOptions)
+
+// WithChunkSize overrides the default 32 MiB chunk size.
+func WithChunkSize(size int64) ChunkedOption {
+	return func(opts *chunkedOptions) {
+		opts.chunkSize = size
+	}
+}
+
+// WithUploadConcurrency overrides the default number of chunks uploaded concurrently.
+func WithUploadConcurrency(n int) ChunkedOption {
+	return func(opts *chunkedOptions) {
+		opts.concurrency = n
+	}
+}
+
+// WithResumeState resumes a chunked upload previously interrupted, picking up from
+// state.Offset instead of starting a new upload session.
+func WithResumeState(state *UploadState) ChunkedOption {
+	return func(opts *chunkedOptions) {
+		opts.resume = state
+	}
+}
+
+// UploadChunked uploads img in fixed-size chunks over a worker pool, allowing resume
+// after a transient network failure. img.file must implement io.ReaderAt (as returned
+// by NewImage, or by passing an *os.File to NewImageFromReader) and img.size must be
+// known in advance.
+//
+// If the endpoint does not support range-based PUTs, UploadChunked falls back to a
+// single-shot Upload.
+func (c *Client) UploadChunked(ctx context.Context, img *Image, opts ...ChunkedOption) (UploadResponse, *UploadState, error) {
+	options := chunkedOptions{
+		chunkSize:   defaultChunkSize,
+		concurrency: defaultUploadConcurrency,
+	}
+
+	for _, o := range opts {
+		o(&options)
+	}
+
+	if img.size <= 0 {
+		return UploadResponse{}, nil, fmt.Errorf("%w: image size must be known for chunked upload", ErrWrongResponse)
+	}
+
+	reader, ok := img.file.(io.ReaderAt)
+	if !ok {
+		return UploadResponse{}, nil, fmt.Errorf("%w: image source must implement io.ReaderAt", ErrWrongResponse)
+	}
+
+	supported, err := c.supportsChunkedUpload(ctx)
+	if err != nil {
+		return UploadResponse{}, nil, err
+	}
+
+	if !supported {
+		resp, err := c.Upload(ctx, img)
+		return resp, nil, err
+	}
+
+	state, err := c.beginOrResumeChunkedUpload(img, options)
+	if err != nil {
+		return UploadResponse{}, state, err
+	}
+
+	if err := c.uploadChunks(ctx, reader, img.size, state, options); err != nil {
+		return UploadResponse{}, state, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, state.UploadURL, nil)
+	if err != nil {
+		return UploadResponse{}, state, fmt.Errorf("%w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return UploadResponse{}, state, fmt.Errorf("%w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return UploadResponse{}, state, fmt.Errorf("%w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return UploadResponse{}, state, &Error{StatusCode: resp.StatusCode, Message: string(body)}
+	}
+
+	return c.parseUploadResponse(body), state, nil
+}
+
+// supportsChunkedUpload probes the endpoint to check whether it accepts range-based
+// PUTs, since oshi.at may or may not support resumable uploads.
+func (c *Client) supportsChunkedUpload(ctx context.Context) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodOptions, c.endpoint, nil)
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+	defer resp.Body.Close()
+
+	return resp.Header.Get("Accept-Ranges") == "bytes", nil
+}
+
+func (c *Client) beginOrResumeChunkedUpload(img *Image, options chunkedOptions) (*UploadState, error) {
+	if options.resume != nil {
+		return options.resume, nil
+	}
+
+	uploadURL, err := c.prepareUploadURL(img)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return &UploadState{UploadURL: uploadURL}, nil
+}
+
+func (c *Client) uploadChunks(ctx context.Context, reader io.ReaderAt, total int64, state *UploadState, options chunkedOptions) error {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+	)
+
+	if state.ChunkChecksums == nil {
+		state.ChunkChecksums = make(map[int64]string)
+	}
+
+	// completedEnd maps a chunk's start offset to the offset the server confirmed it
+	// received up to, so state.Offset can be advanced as a contiguous high-water mark
+	// regardless of the order in which concurrent chunk uploads finish.
+	completedEnd := make(map[int64]int64)
+
+	offsets := make(chan int64)
+
+	for i := 0; i < options.concurrency; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for start := range offsets {
+				end := start + options.chunkSize
+				if end > total {
+					end = total
+				}
+
+				checksum, confirmedEnd, err := c.uploadChunkWithRetry(ctx, reader, state.UploadURL, start, end, total)
+
+				mu.Lock()
+				if err != nil {
+					if firstErr == nil {
+						firstErr = err
+					}
+				} else {
+					state.ChunkChecksums[start/options.chunkSize] = checksum
+					completedEnd[start] = confirmedEnd
+					advanceOffset(state, completedEnd)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for start := state.Offset; start < total; start += options.chunkSize {
+		offsets <- start
+	}
+	close(offsets)
+
+	wg.Wait()
+
+	return firstErr
+}
+
+// advanceOffset moves state.Offset forward through completedEnd as far as the
+// contiguously completed chunks allow, so a chunk that hasn't finished (or failed)
+// never gets skipped over by a later-finishing chunk.
+func advanceOffset(state *UploadState, completedEnd map[int64]int64) {
+	for {
+		end, ok := completedEnd[state.Offset]
+		if !ok {
+			return
+		}
+
+		delete(completedEnd, state.Offset)
+
+		state.Offset = end
+	}
+}
+
+func (c *Client) uploadChunkWithRetry(ctx context.Context, reader io.ReaderAt, uploadURL string, start, end, total int64) (string, int64, error) {
+	backoff := chunkUploadBackoffInitial
+
+	var lastErr error
+
+	for attempt := 1; attempt <= chunkUploadMaxAttempts; attempt++ {
+		checksum, confirmedEnd, err := c.uploadChunk(ctx, reader, uploadURL, start, end, total)
+		if err == nil {
+			return checksum, confirmedEnd, nil
+		}
+
+		lastErr = err
+
+		select {
+		case <-ctx.Done():
+			return "", 0, fmt.Errorf("%w", ctx.Err())
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > chunkUploadBackoffMax {
+			backoff = chunkUploadBackoffMax
+		}
+	}
+
+	return "", 0, lastErr
+}
+
+func (c *Client) uploadChunk(ctx context.Context, reader io.ReaderAt, uploadURL string, start, end, total int64) (string, int64, error) {
+	buf := make([]byte, end-start)
+	if _, err := reader.ReadAt(buf, start); err != nil && !errors.Is(err, io.EOF) {
+		return "", 0, fmt.Errorf("%w", err)
+	}
+
+	sum := sha256.Sum256(buf)
+	checksum := hex.EncodeToString(sum[:])
+
+	var reqBody io.Reader = bytes.NewReader(buf)
+	if c.uploadLimiter != nil {
+		reqBody = &throttledReader{ctx: ctx, r: reqBody, bucket: c.uploadLimiter}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uploadURL, reqBody)
+	if err != nil {
+		return "", 0, fmt.Errorf("%w", err)
+	}
+
+	req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end-1, total))
+	req.ContentLength = end - start
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", 0, fmt.Errorf("%w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", 0, fmt.Errorf("%w", err)
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return "", 0, &Error{StatusCode: resp.StatusCode, Message: string(body)}
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return "", 0, &Error{StatusCode: resp.StatusCode, Message: string(body)}
+	}
+
+	return checksum, rangeEndFromHeader(resp.Header.Get("Range"), end), nil
+}
+
+// rangeEndFromHeader parses the server-reported committed range (e.g. "bytes=0-1023")
+// from a chunk upload response, returning the exclusive end offset it confirms. If the
+// header is absent or malformed, the requested end offset is trusted instead.
+func rangeEndFromHeader(header string, requestedEnd int64) int64 {
+	if header == "" {
+		return requestedEnd
+	}
+
+	header = strings.TrimPrefix(header, "bytes=")
+
+	dash := strings.LastIndex(header, "-")
+	if dash < 0 {
+		return requestedEnd
+	}
+
+	end, err := strconv.ParseInt(strings.TrimSpace(header[dash+1:]), 10, 64)
+	if err != nil {
+		return requestedEnd
+	}
+
+	return end + 1
+}
+
+// Marshal serializes the upload state to JSON so callers can persist progress across
+// process restarts.
+func (s *UploadState) Marshal() ([]byte, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return data, nil
+}
+
+// ParseUploadState parses an upload state previously produced by UploadState.Marshal.
+func ParseUploadState(data []byte) (*UploadState, error) {
+	var state UploadState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	return &state, nil
+}
@@ -45,6 +45,7 @@ func (e *Error) Error() string {
 
 type Image struct {
 	file        io.Reader
+	size        int64
 	filename    string
 	expire      uint64
 	autodestroy bool
@@ -52,22 +53,57 @@ type Image struct {
 	shorturl    bool
 }
 
-func NewImage(
-	file []byte,
-	filename string,
-	expire uint64,
-	autodestroy bool,
-	randomizefn bool,
-	shorturl bool,
-) *Image {
-	return &Image{
-		file:        bytes.NewReader(file),
-		filename:    filename,
-		expire:      expire,
-		autodestroy: autodestroy,
-		randomizefn: randomizefn,
-		shorturl:    shorturl,
+// ImageOption helps to configurate oshi image.
+type ImageOption func(img *Image)
+
+// WithExpire sets file expiration time in days.
+func WithExpire(expire uint64) ImageOption {
+	return func(img *Image) {
+		img.expire = expire
+	}
+}
+
+// WithAutodestroy makes the file be deleted right after it's downloaded.
+func WithAutodestroy(autodestroy bool) ImageOption {
+	return func(img *Image) {
+		img.autodestroy = autodestroy
+	}
+}
+
+// WithRandomizeFilename makes oshi.at generate a random name for the file instead of using the original one.
+func WithRandomizeFilename(randomizefn bool) ImageOption {
+	return func(img *Image) {
+		img.randomizefn = randomizefn
+	}
+}
+
+// WithShortURL makes oshi.at return a shortened download URL.
+func WithShortURL(shorturl bool) ImageOption {
+	return func(img *Image) {
+		img.shorturl = shorturl
+	}
+}
+
+// NewImage creates a new image from an in-memory byte slice.
+func NewImage(file []byte, filename string, opts ...ImageOption) *Image {
+	return NewImageFromReader(bytes.NewReader(file), int64(len(file)), filename, opts...)
+}
+
+// NewImageFromReader creates a new image streamed from r, avoiding buffering the whole
+// file in memory. size is the total number of bytes r will yield and is used as the
+// request's Content-Length and to report upload progress.
+func NewImageFromReader(r io.Reader, size int64, filename string, opts ...ImageOption) *Image {
+	img := &Image{
+		file:     r,
+		size:     size,
+		filename: filename,
+	}
+
+	for _, o := range opts {
+		o(img)
 	}
+
+	return img
 }
 
 type UploadResponse struct {
@@ -95,14 +131,19 @@ func WithEndpoint(endpoint string) Option {
 type Client struct {
 	endpoint string
 
-	httpClient *http.Client
+	httpClient  *http.Client
+	retryPolicy RetryPolicy
+
+	uploadLimiter   *tokenBucket
+	downloadLimiter *tokenBucket
 }
 
 // NewClient create a new oshi.at api client.
 func NewClient(httpClient *http.Client, opts ...Option) *Client {
 	client := &Client{
-		endpoint:   defaultEndpoint,
-		httpClient: httpClient,
+		endpoint:    defaultEndpoint,
+		httpClient:  httpClient,
+		retryPolicy: NoRetryPolicy,
 	}
 
 	for _, o := range opts {
@@ -119,18 +160,9 @@ func (c *Client) GetTorEndpoint(ctx context.Context) (string, error) {
 		return "", fmt.Errorf("%w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
-	if err != nil {
-		return "", fmt.Errorf("%w", err)
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return "", fmt.Errorf("%w", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
+	resp, body, err := c.doWithRetry(ctx, false, nil, func() (*http.Request, error) {
+		return http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	})
 	if err != nil {
 		return "", fmt.Errorf("%w", err)
 	}
@@ -152,18 +184,9 @@ func (c *Client) GetHashsum(ctx context.Context, file string) (GetHashsumRespons
 		return GetHashsumResponse{}, fmt.Errorf("%w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
-	if err != nil {
-		return GetHashsumResponse{}, fmt.Errorf("%w", err)
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return GetHashsumResponse{}, fmt.Errorf("%w", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
+	resp, body, err := c.doWithRetry(ctx, false, nil, func() (*http.Request, error) {
+		return http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	})
 	if err != nil {
 		return GetHashsumResponse{}, fmt.Errorf("%w", err)
 	}
@@ -193,23 +216,14 @@ func (c *Client) parseHashsumResponse(body []byte) (GetHashsumResponse, error) {
 
 // Delete delete file.
 func (c *Client) Delete(ctx context.Context, adminURL string) error {
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, adminURL, nil)
+	resp, body, err := c.doWithRetry(ctx, false, nil, func() (*http.Request, error) {
+		return http.NewRequestWithContext(ctx, http.MethodDelete, adminURL, nil)
+	})
 	if err != nil {
 		return fmt.Errorf("%w", err)
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("%w", err)
-	}
-	defer resp.Body.Close()
-
 	if resp.StatusCode != http.StatusOK {
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return fmt.Errorf("%w", err)
-		}
-
 		return &Error{
 			StatusCode: resp.StatusCode,
 			Message:    string(body),
@@ -219,25 +233,80 @@ func (c *Client) Delete(ctx context.Context, adminURL string) error {
 	return nil
 }
 
+// ProgressFunc is called periodically while an upload's request body is being read,
+// reporting how many bytes have been sent so far out of totalBytes.
+type ProgressFunc func(bytesSent, totalBytes int64)
+
+type uploadOptions struct {
+	onProgress ProgressFunc
+}
+
+// UploadOption helps to configurate a single Upload call.
+type UploadOption func(opts *uploadOptions)
+
+// WithProgress reports upload progress via fn as the request body is streamed.
+func WithProgress(fn ProgressFunc) UploadOption {
+	return func(opts *uploadOptions) {
+		opts.onProgress = fn
+	}
+}
+
+// progressReader wraps an io.Reader, reporting cumulative bytes read via onProgress.
+type progressReader struct {
+	r          io.Reader
+	total      int64
+	read       int64
+	onProgress ProgressFunc
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.read += int64(n)
+		p.onProgress(p.read, p.total)
+	}
+
+	return n, err
+}
+
 // Upload uploads file.
-func (c *Client) Upload(ctx context.Context, img *Image) (UploadResponse, error) {
-	url, err := c.prepareUploadURL(img)
-	if err != nil {
-		return UploadResponse{}, fmt.Errorf("%w", err)
+func (c *Client) Upload(ctx context.Context, img *Image, opts ...UploadOption) (UploadResponse, error) {
+	options := uploadOptions{}
+	for _, o := range opts {
+		o(&options)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, img.file)
+	url, err := c.prepareUploadURL(img)
 	if err != nil {
 		return UploadResponse{}, fmt.Errorf("%w", err)
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return UploadResponse{}, fmt.Errorf("%w", err)
+	var rewind func() error
+	if _, seekable := img.file.(io.Seeker); seekable {
+		rewind = img.Rewind
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	resp, body, err := c.doWithRetry(ctx, true, rewind, func() (*http.Request, error) {
+		var reqBody io.Reader = img.file
+		if c.uploadLimiter != nil {
+			reqBody = &throttledReader{ctx: ctx, r: reqBody, bucket: c.uploadLimiter}
+		}
+
+		if options.onProgress != nil {
+			reqBody = &progressReader{r: reqBody, total: img.size, onProgress: options.onProgress}
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, reqBody)
+		if err != nil {
+			return nil, err //nolint:wrapcheck // wrapped by doWithRetry's caller
+		}
+
+		if img.size > 0 {
+			req.ContentLength = img.size
+		}
+
+		return req, nil
+	})
 	if err != nil {
 		return UploadResponse{}, fmt.Errorf("%w", err)
 	}
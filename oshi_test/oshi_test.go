@@ -1,9 +1,11 @@
 package oshi_test
 
 import (
+	"bytes"
 	"context"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
@@ -26,7 +28,7 @@ func Test_Upload(t *testing.T) {
 
 	client := oshi.NewClient(&http.Client{})
 
-	img := oshi.NewImage(prepareData(t), "name_test", 5, true, false, false)
+	img := oshi.NewImage(prepareData(t), "name_test", oshi.WithExpire(5), oshi.WithAutodestroy(true))
 
 	wg := sync.WaitGroup{}
 	wg.Add(5)
@@ -44,7 +46,7 @@ func Test_GetHashsum(t *testing.T) {
 
 	client := oshi.NewClient(&http.Client{})
 
-	img := oshi.NewImage(prepareData(t), "name_test", 5, true, false, false)
+	img := oshi.NewImage(prepareData(t), "name_test", oshi.WithExpire(5), oshi.WithAutodestroy(true))
 
 	wg := sync.WaitGroup{}
 	wg.Add(5)
@@ -63,7 +65,7 @@ func Test_Delete(t *testing.T) {
 
 	client := oshi.NewClient(&http.Client{})
 
-	img := oshi.NewImage(prepareData(t), "name_test", 5, true, false, false)
+	img := oshi.NewImage(prepareData(t), "name_test", oshi.WithExpire(5), oshi.WithAutodestroy(true))
 
 	wg := sync.WaitGroup{}
 	wg.Add(5)
@@ -75,6 +77,119 @@ func Test_Delete(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func Test_Upload_FromReader_WithProgress(t *testing.T) {
+	ctx := context.Background()
+
+	client := oshi.NewClient(&http.Client{})
+
+	data := prepareData(t)
+	img := oshi.NewImageFromReader(bytes.NewReader(data), int64(len(data)), "name_test", oshi.WithExpire(5), oshi.WithAutodestroy(true))
+
+	var lastSent int64
+
+	resp, err := client.Upload(ctx, img, oshi.WithProgress(func(bytesSent, totalBytes int64) {
+		lastSent = bytesSent
+		assert.Equal(t, int64(len(data)), totalBytes)
+	}))
+	assert.NoError(t, err)
+
+	assert.Equal(t, int64(len(data)), lastSent)
+	assert.NotEmpty(t, resp.Admin)
+	assert.NotEmpty(t, resp.Download)
+	assert.NotEmpty(t, resp.TorDownload)
+}
+
+func Test_UploadChunked(t *testing.T) {
+	ctx := context.Background()
+
+	client := oshi.NewClient(&http.Client{})
+
+	img := oshi.NewImage(prepareData(t), "name_test", oshi.WithExpire(5), oshi.WithAutodestroy(true))
+
+	resp, state, err := client.UploadChunked(ctx, img, oshi.WithChunkSize(1024), oshi.WithUploadConcurrency(2))
+	assert.NoError(t, err)
+
+	assert.NotEmpty(t, resp.Admin)
+	assert.NotEmpty(t, resp.Download)
+
+	if state != nil {
+		data, err := state.Marshal()
+		assert.NoError(t, err)
+		assert.NotEmpty(t, data)
+	}
+}
+
+func Test_Download(t *testing.T) {
+	ctx := context.Background()
+
+	client := oshi.NewClient(&http.Client{})
+
+	data := prepareData(t)
+	img := oshi.NewImage(data, "name_test", oshi.WithExpire(5), oshi.WithAutodestroy(true))
+
+	uploadResp, err := client.Upload(ctx, img)
+	assert.NoError(t, err)
+
+	var buf bytes.Buffer
+
+	resp, err := client.Download(ctx, uploadResp.Download, &buf)
+	assert.NoError(t, err)
+
+	assert.Equal(t, data, buf.Bytes())
+	assert.NotEmpty(t, resp.Hashsum)
+}
+
+func Test_DownloadToFile(t *testing.T) {
+	ctx := context.Background()
+
+	client := oshi.NewClient(&http.Client{})
+
+	data := prepareData(t)
+	img := oshi.NewImage(data, "name_test", oshi.WithExpire(5), oshi.WithAutodestroy(true))
+
+	uploadResp, err := client.Upload(ctx, img)
+	assert.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "gopher.png")
+
+	err = client.DownloadToFile(ctx, uploadResp.Download, path)
+	assert.NoError(t, err)
+
+	got, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, data, got)
+}
+
+func Test_UploadBatch_DeleteBatch(t *testing.T) {
+	ctx := context.Background()
+
+	client := oshi.NewClient(&http.Client{})
+
+	data := prepareData(t)
+	imgs := []*oshi.Image{
+		oshi.NewImage(data, "name_test_1", oshi.WithExpire(5), oshi.WithAutodestroy(true)),
+		oshi.NewImage(data, "name_test_2", oshi.WithExpire(5), oshi.WithAutodestroy(true)),
+	}
+
+	results, err := client.UploadBatch(ctx, imgs, oshi.WithBatchConcurrency(2))
+	assert.NoError(t, err)
+	assert.Len(t, results, len(imgs))
+
+	adminURLs := make([]string, 0, len(results))
+
+	for _, result := range results {
+		assert.NoError(t, result.Err)
+		assert.NotEmpty(t, result.Response.Admin)
+
+		adminURLs = append(adminURLs, result.Response.Admin)
+	}
+
+	errs := client.DeleteBatch(ctx, adminURLs)
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+}
+
 func Test_GetTorEndpoint(t *testing.T) {
 	ctx := context.Background()
 
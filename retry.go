@@ -0,0 +1,187 @@
+package oshi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	retryBaseDelay    = 200 * time.Millisecond
+	retryFactor       = 2
+	retryCap          = 30 * time.Second
+	retryMaxAttempts  = 5
+	retryJitterFactor = 0.5
+)
+
+// ErrNotSeekable is returned by Image.Rewind when the image's underlying reader does
+// not implement io.Seeker, meaning it cannot be retried.
+var ErrNotSeekable = errors.New("image reader is not seekable")
+
+// RetryPolicy decides whether a request should be retried after a failed attempt.
+// attempt is 1-indexed and counts the request that just failed.
+type RetryPolicy interface {
+	ShouldRetry(resp *http.Response, err error, attempt int) (retry bool, sleep time.Duration)
+}
+
+// DefaultRetryPolicy retries network errors, 429 (honoring Retry-After) and 5xx
+// responses with exponential backoff and jitter, up to 5 attempts.
+var DefaultRetryPolicy RetryPolicy = defaultRetryPolicy{}
+
+// NoRetryPolicy never retries, restoring the library's original one-shot behavior.
+var NoRetryPolicy RetryPolicy = noRetryPolicy{}
+
+type defaultRetryPolicy struct{}
+
+func (defaultRetryPolicy) ShouldRetry(resp *http.Response, err error, attempt int) (bool, time.Duration) {
+	if attempt >= retryMaxAttempts {
+		return false, 0
+	}
+
+	if err != nil {
+		return true, backoff(attempt)
+	}
+
+	if resp == nil {
+		return false, 0
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		if d, ok := retryAfter(resp); ok {
+			return true, d
+		}
+
+		return true, backoff(attempt)
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return true, backoff(attempt)
+	default:
+		return false, 0
+	}
+}
+
+func backoff(attempt int) time.Duration {
+	d := retryBaseDelay * time.Duration(pow(retryFactor, attempt-1))
+	if d > retryCap {
+		d = retryCap
+	}
+
+	jitter := time.Duration(rand.Float64() * retryJitterFactor * float64(d)) //nolint:gosec // not used for security
+
+	return d + jitter
+}
+
+func pow(base, exp int) int {
+	result := 1
+	for i := 0; i < exp; i++ {
+		result *= base
+	}
+
+	return result
+}
+
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if when, err := http.ParseTime(v); err == nil {
+		return time.Until(when), true
+	}
+
+	return 0, false
+}
+
+type noRetryPolicy struct{}
+
+func (noRetryPolicy) ShouldRetry(_ *http.Response, _ error, _ int) (bool, time.Duration) {
+	return false, 0
+}
+
+// WithRetry overrides the client's retry policy. Pass NoRetryPolicy to disable
+// retries entirely.
+func WithRetry(policy RetryPolicy) Option {
+	return func(client *Client) {
+		client.retryPolicy = policy
+	}
+}
+
+// Rewind resets the image's underlying reader to the beginning so its body can be
+// re-sent on retry. It returns ErrNotSeekable if the reader does not implement
+// io.Seeker; non-seekable readers opt out of retry for the upload body.
+func (img *Image) Rewind() error {
+	seeker, ok := img.file.(io.Seeker)
+	if !ok {
+		return ErrNotSeekable
+	}
+
+	if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+		return err //nolint:wrapcheck // caller wraps with context-specific %w
+	}
+
+	return nil
+}
+
+// doWithRetry executes the request built by newReq, retrying according to
+// c.retryPolicy. hasBody must be true when newReq's request carries a body; rewind is
+// then called before each retry to reset that body and retry is skipped if it returns
+// an error. If hasBody is true and rewind is nil, the body cannot be replayed (e.g. a
+// non-seekable upload reader), so the request is never retried regardless of policy.
+// It returns the final response (body already drained into the returned bytes) and any
+// error from the last attempt.
+func (c *Client) doWithRetry(ctx context.Context, hasBody bool, rewind func() error, newReq func() (*http.Request, error)) (*http.Response, []byte, error) {
+	policy := c.retryPolicy
+	if policy == nil {
+		policy = NoRetryPolicy
+	}
+
+	for attempt := 1; ; attempt++ {
+		req, err := newReq()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resp, doErr := c.httpClient.Do(req)
+
+		var body []byte
+
+		if resp != nil {
+			body, err = io.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			if err != nil {
+				doErr = err
+			}
+		}
+
+		retry, sleep := policy.ShouldRetry(resp, doErr, attempt)
+		if !retry {
+			return resp, body, doErr
+		}
+
+		if hasBody && rewind == nil {
+			return resp, body, doErr
+		}
+
+		if rewind != nil {
+			if err := rewind(); err != nil {
+				return resp, body, doErr
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return resp, body, ctx.Err()
+		case <-time.After(sleep):
+		}
+	}
+}